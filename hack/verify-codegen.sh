@@ -42,10 +42,14 @@ diff -Naupr "${BUILD_ROOT_DIR}/pkg" "${TMP_DIFFROOT}/pkg" || ret=1
 rm -fr "${BUILD_ROOT_DIR}/Gopkg.lock" "${BUILD_ROOT_DIR}/pkg" "${BUILD_ROOT_DIR}/vendor"
 cp -aR "${TMP_DIFFROOT}"/* "${BUILD_ROOT_DIR}"
 
+# Make sure the generators still produce the committed example outputs
+echo "Checking the generator snapshots of ${BUILD_ROOT_DIR}/vendor/k8s.io/code-generator/_examples"
+(cd "${BUILD_ROOT_DIR}/vendor/k8s.io/code-generator" && go run ./cmd/snapshot-test) || ret=1
+
 if [[ $ret -eq 0 ]]
 then
   echo "${BUILD_ROOT_DIR} up to date."
 else
-  echo "${BUILD_ROOT_DIR} is out of date. Please run hack/update-codegen.sh"
+  echo "${BUILD_ROOT_DIR} is out of date. Please run hack/update-codegen.sh, and snapshot-test --update in vendor/k8s.io/code-generator for the snapshots"
   exit 1
 fi